@@ -209,6 +209,7 @@ var match = map[string]KeywordKind{
 	"NCLOB":                            Matched,
 	"NEW":                              Matched,
 	"NO":                               Matched,
+	"NOCYCLE":                          Matched,
 	"NONE":                             Matched,
 	"NORMALIZE":                        Matched,
 	"NOT":                              Matched,
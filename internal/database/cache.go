@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"log"
 	"sort"
 	"strings"
 )
@@ -49,6 +50,17 @@ func (u *DBCacheGenerator) GenerateDBCachePrimary(ctx context.Context) (*DBCache
 		dbCache.SchemaTables[strings.ToUpper(index)] = element
 	}
 
+	// Not every server exposes a views catalog to the user, and completion
+	// works without views, so a failure here is not fatal.
+	schemaViews, err := u.repo.SchemaViews(ctx)
+	if err != nil {
+		log.Println("views", err.Error())
+	}
+	dbCache.SchemaViews = make(map[string][]string)
+	for index, element := range schemaViews {
+		dbCache.SchemaViews[strings.ToUpper(index)] = element
+	}
+
 	dbCache.ColumnsWithParent, err = u.genColumnCacheCurrent(ctx, dbCache.defaultSchema)
 	if err != nil {
 		return nil, err
@@ -131,6 +143,7 @@ type DBCache struct {
 	defaultSchema     string
 	Schemas           map[string]string
 	SchemaTables      map[string][]string
+	SchemaViews       map[string][]string
 	ColumnsWithParent map[string][]*ColumnDesc
 	ForeignKeys       map[string]map[string][]*ForeignKey
 }
@@ -160,11 +173,52 @@ func (dc *DBCache) SortedTables() []string {
 	return tbls
 }
 
+// Views returns the sorted view names of schema. Most drivers read
+// SchemaTables from information_schema.tables, so views are usually listed
+// there as well.
+func (dc *DBCache) Views(schema string) []string {
+	views := append([]string{}, dc.SchemaViews[strings.ToUpper(schema)]...)
+	sort.Strings(views)
+	return views
+}
+
 func (dc *DBCache) ColumnDescs(tableName string) (cols []*ColumnDesc, ok bool) {
 	cols, ok = dc.ColumnsWithParent[columnDatabaseKey(dc.defaultSchema, tableName)]
 	return
 }
 
+// ColumnDescsWithDefault splits the columns of tableName into those an
+// INSERT has to supply and those it may leave out because they have a
+// default value or accept NULL. Auto-increment, identity and generated
+// columns are optional as well, as far as the driver reports them in Extra;
+// the MySQL, PostgreSQL and SQL Server drivers do.
+func (dc *DBCache) ColumnDescsWithDefault(tableName string) (required, optional []*ColumnDesc, ok bool) {
+	cols, ok := dc.ColumnDescs(tableName)
+	if !ok {
+		return nil, nil, false
+	}
+	for _, col := range cols {
+		if isInsertOptional(col) {
+			optional = append(optional, col)
+		} else {
+			required = append(required, col)
+		}
+	}
+	return required, optional, true
+}
+
+func isInsertOptional(col *ColumnDesc) bool {
+	if col.Default.Valid {
+		return true
+	}
+	switch strings.ToUpper(col.Null) {
+	case "YES", "Y":
+		return true
+	}
+	extra := strings.ToLower(col.Extra)
+	return strings.Contains(extra, "auto_increment") || strings.Contains(extra, "identity") || strings.Contains(extra, "generated")
+}
+
 func (dc *DBCache) ColumnDatabase(dbName, tableName string) (cols []*ColumnDesc, ok bool) {
 	cols, ok = dc.ColumnsWithParent[columnDatabaseKey(dbName, tableName)]
 	return
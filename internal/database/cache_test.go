@@ -0,0 +1,107 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDBCache_ColumnDescsWithDefault(t *testing.T) {
+	cache := &DBCache{
+		defaultSchema: "world",
+		ColumnsWithParent: map[string][]*ColumnDesc{
+			columnDatabaseKey("world", "city"): {
+				{
+					ColumnBase: ColumnBase{Schema: "world", Table: "city", Name: "ID"},
+					Null:       "NO",
+					Extra:      "auto_increment",
+				},
+				{
+					ColumnBase: ColumnBase{Schema: "world", Table: "city", Name: "Code"},
+					Null:       "NO",
+					Extra:      "identity",
+				},
+				{
+					ColumnBase: ColumnBase{Schema: "world", Table: "city", Name: "Name"},
+					Null:       "NO",
+				},
+				{
+					ColumnBase: ColumnBase{Schema: "world", Table: "city", Name: "CountryCode"},
+					Null:       "NO",
+				},
+				{
+					ColumnBase: ColumnBase{Schema: "world", Table: "city", Name: "District"},
+					Null:       "YES",
+				},
+				{
+					ColumnBase: ColumnBase{Schema: "world", Table: "city", Name: "Population"},
+					Null:       "NO",
+					Default:    sql.NullString{String: "0", Valid: true},
+				},
+				{
+					ColumnBase: ColumnBase{Schema: "world", Table: "city", Name: "NameLength"},
+					Null:       "NO",
+					Extra:      "STORED GENERATED",
+				},
+			},
+		},
+	}
+
+	required, optional, ok := cache.ColumnDescsWithDefault("city")
+	if !ok {
+		t.Fatalf("table not found")
+	}
+	if got := strings.Join(columnNames(required), ","); got != "Name,CountryCode" {
+		t.Errorf("unexpected required columns, got %q", got)
+	}
+	if got := strings.Join(columnNames(optional), ","); got != "ID,Code,District,Population,NameLength" {
+		t.Errorf("unexpected optional columns, got %q", got)
+	}
+
+	if _, _, ok := cache.ColumnDescsWithDefault("country"); ok {
+		t.Errorf("expected table not found")
+	}
+}
+
+func TestDBCache_Views(t *testing.T) {
+	cache, err := NewDBCacheUpdater(NewMockDBRepository(nil)).GenerateDBCachePrimary(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(cache.Views("WORLD"), ","); got != "city_view" {
+		t.Errorf("unexpected views, got %q", got)
+	}
+	if got := cache.SortedTables(); strings.Join(got, ",") != "city,country,countrylanguage" {
+		t.Errorf("views must not be listed as tables, got %q", got)
+	}
+	if got := cache.Views("other"); len(got) != 0 {
+		t.Errorf("expected no views, got %q", got)
+	}
+}
+
+func TestDBCache_ViewsError(t *testing.T) {
+	repo := NewMockDBRepository(nil).(*MockDBRepository)
+	repo.MockDatabaseViews = func(ctx context.Context) (map[string][]string, error) {
+		return nil, errors.New("permission denied")
+	}
+	cache, err := NewDBCacheUpdater(repo).GenerateDBCachePrimary(context.Background())
+	if err != nil {
+		t.Fatalf("views error must not fail the cache, got %v", err)
+	}
+	if got := cache.Views("world"); len(got) != 0 {
+		t.Errorf("expected no views, got %q", got)
+	}
+	if got := cache.SortedTables(); len(got) == 0 {
+		t.Errorf("expected tables")
+	}
+}
+
+func columnNames(cols []*ColumnDesc) []string {
+	names := []string{}
+	for _, col := range cols {
+		names = append(names, col.Name)
+	}
+	return names
+}
@@ -376,6 +376,34 @@ func (db *clickhouseSQLDBRepository) SchemaTables(ctx context.Context) (map[stri
 	return databaseTables, nil
 }
 
+func (db *clickhouseSQLDBRepository) SchemaViews(ctx context.Context) (map[string][]string, error) {
+	rows, err := db.Conn.QueryContext(
+		ctx,
+		`
+    SELECT table_schema, table_name
+      FROM information_schema.views
+     ORDER BY table_schema, table_name
+    `)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	databaseViews := map[string][]string{}
+	for rows.Next() {
+		var schema, view string
+		if err := rows.Scan(&schema, &view); err != nil {
+			return nil, err
+		}
+
+		if arr, ok := databaseViews[schema]; ok {
+			databaseViews[schema] = append(arr, view)
+		} else {
+			databaseViews[schema] = []string{view}
+		}
+	}
+	return databaseViews, nil
+}
+
 func (db *clickhouseSQLDBRepository) Schemas(ctx context.Context) ([]string, error) {
 	return db.Databases(ctx)
 }
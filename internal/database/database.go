@@ -28,6 +28,7 @@ type DBRepository interface {
 	CurrentSchema(ctx context.Context) (string, error)
 	Schemas(ctx context.Context) ([]string, error)
 	SchemaTables(ctx context.Context) (map[string][]string, error)
+	SchemaViews(ctx context.Context) (map[string][]string, error)
 	DescribeDatabaseTable(ctx context.Context) ([]*ColumnDesc, error)
 	DescribeDatabaseTableBySchema(ctx context.Context, schemaName string) ([]*ColumnDesc, error)
 	Exec(ctx context.Context, query string) (sql.Result, error)
@@ -53,6 +54,9 @@ type ColumnDesc struct {
 	Key     string
 	Default sql.NullString
 	Extra   string
+	// Charset is the character set of a string column. It is only filled
+	// in by drivers that report one per column, such as MySQL.
+	Charset string
 }
 
 type ForeignKey [][2]*ColumnBase
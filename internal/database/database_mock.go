@@ -11,6 +11,7 @@ type MockDBRepository struct {
 	MockDatabase                      func(context.Context) (string, error)
 	MockDatabases                     func(context.Context) ([]string, error)
 	MockDatabaseTables                func(context.Context) (map[string][]string, error)
+	MockDatabaseViews                 func(context.Context) (map[string][]string, error)
 	MockTables                        func(context.Context) ([]string, error)
 	MockDescribeTable                 func(context.Context, string) ([]*ColumnDesc, error)
 	MockDescribeDatabaseTable         func(context.Context) ([]*ColumnDesc, error)
@@ -25,6 +26,7 @@ func NewMockDBRepository(_ *sql.DB) DBRepository {
 		MockDatabase:       func(ctx context.Context) (string, error) { return "world", nil },
 		MockDatabases:      func(ctx context.Context) ([]string, error) { return dummyDatabases, nil },
 		MockDatabaseTables: func(ctx context.Context) (map[string][]string, error) { return dummyDatabaseTables, nil },
+		MockDatabaseViews:  func(ctx context.Context) (map[string][]string, error) { return dummyDatabaseViews, nil },
 		MockTables:         func(ctx context.Context) ([]string, error) { return dummyTables, nil },
 		MockDescribeTable: func(ctx context.Context, tableName string) ([]*ColumnDesc, error) {
 			switch tableName {
@@ -92,6 +94,10 @@ func (m *MockDBRepository) SchemaTables(ctx context.Context) (map[string][]strin
 	return m.MockDatabaseTables(ctx)
 }
 
+func (m *MockDBRepository) SchemaViews(ctx context.Context) (map[string][]string, error) {
+	return m.MockDatabaseViews(ctx)
+}
+
 func (m *MockDBRepository) Tables(ctx context.Context) ([]string, error) {
 	return m.MockTables(ctx)
 }
@@ -130,6 +136,11 @@ var dummyDatabaseTables = map[string][]string{
 		"countrylanguage",
 	},
 }
+var dummyDatabaseViews = map[string][]string{
+	"world": {
+		"city_view",
+	},
+}
 var dummyTables = []string{
 	"city",
 	"country",
@@ -189,6 +189,39 @@ func (db *MssqlDBRepository) SchemaTables(ctx context.Context) (map[string][]str
 	return databaseTables, nil
 }
 
+func (db *MssqlDBRepository) SchemaViews(ctx context.Context) (map[string][]string, error) {
+	rows, err := db.Conn.QueryContext(
+		ctx,
+		`
+	SELECT
+		TABLE_SCHEMA,
+		TABLE_NAME
+	FROM
+		INFORMATION_SCHEMA.VIEWS
+	ORDER BY
+		TABLE_SCHEMA,
+		TABLE_NAME
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	databaseViews := map[string][]string{}
+	for rows.Next() {
+		var schema, view string
+		if err := rows.Scan(&schema, &view); err != nil {
+			return nil, err
+		}
+
+		if arr, ok := databaseViews[schema]; ok {
+			databaseViews[schema] = append(arr, view)
+		} else {
+			databaseViews[schema] = []string{view}
+		}
+	}
+	return databaseViews, nil
+}
+
 func (db *MssqlDBRepository) Tables(ctx context.Context) ([]string, error) {
 	rows, err := db.Conn.QueryContext(
 		ctx,
@@ -233,7 +266,11 @@ func (db *MssqlDBRepository) DescribeDatabaseTable(ctx context.Context) ([]*Colu
 			ELSE 'NO'
 		END,
 		c.COLUMN_DEFAULT,
-		''
+		CASE
+			WHEN COLUMNPROPERTY(OBJECT_ID(QUOTENAME(c.TABLE_SCHEMA) + '.' + QUOTENAME(c.TABLE_NAME)), c.COLUMN_NAME, 'IsIdentity') = 1 THEN 'identity'
+			WHEN COLUMNPROPERTY(OBJECT_ID(QUOTENAME(c.TABLE_SCHEMA) + '.' + QUOTENAME(c.TABLE_NAME)), c.COLUMN_NAME, 'IsComputed') = 1 THEN 'generated'
+			ELSE ''
+		END
 	FROM
 		INFORMATION_SCHEMA.COLUMNS c
 	LEFT JOIN
@@ -289,7 +326,11 @@ func (db *MssqlDBRepository) DescribeDatabaseTableBySchema(ctx context.Context,
 			ELSE 'NO'
 		END,
 		c.COLUMN_DEFAULT,
-		''
+		CASE
+			WHEN COLUMNPROPERTY(OBJECT_ID(QUOTENAME(c.TABLE_SCHEMA) + '.' + QUOTENAME(c.TABLE_NAME)), c.COLUMN_NAME, 'IsIdentity') = 1 THEN 'identity'
+			WHEN COLUMNPROPERTY(OBJECT_ID(QUOTENAME(c.TABLE_SCHEMA) + '.' + QUOTENAME(c.TABLE_NAME)), c.COLUMN_NAME, 'IsComputed') = 1 THEN 'generated'
+			ELSE ''
+		END
 	FROM
 		INFORMATION_SCHEMA.COLUMNS c
 	LEFT JOIN
@@ -206,6 +206,39 @@ func (db *MySQLDBRepository) SchemaTables(ctx context.Context) (map[string][]str
 	return databaseTables, nil
 }
 
+func (db *MySQLDBRepository) SchemaViews(ctx context.Context) (map[string][]string, error) {
+	rows, err := db.Conn.QueryContext(
+		ctx,
+		`
+	SELECT
+		TABLE_SCHEMA,
+		TABLE_NAME
+	FROM
+		information_schema.VIEWS
+	ORDER BY
+		TABLE_SCHEMA,
+		TABLE_NAME
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	databaseViews := map[string][]string{}
+	for rows.Next() {
+		var schema, view string
+		if err := rows.Scan(&schema, &view); err != nil {
+			return nil, err
+		}
+
+		if arr, ok := databaseViews[schema]; ok {
+			databaseViews[schema] = append(arr, view)
+		} else {
+			databaseViews[schema] = []string{view}
+		}
+	}
+	return databaseViews, nil
+}
+
 func (db *MySQLDBRepository) Tables(ctx context.Context) ([]string, error) {
 	rows, err := db.Conn.QueryContext(ctx, "SHOW TABLES")
 	if err != nil {
@@ -235,7 +268,8 @@ SELECT
 	IS_NULLABLE,
 	COLUMN_KEY,
 	COLUMN_DEFAULT,
-	EXTRA
+	EXTRA,
+	IFNULL(CHARACTER_SET_NAME, '')
 FROM information_schema.COLUMNS
 `)
 	if err != nil {
@@ -254,6 +288,7 @@ FROM information_schema.COLUMNS
 			&tableInfo.Key,
 			&tableInfo.Default,
 			&tableInfo.Extra,
+			&tableInfo.Charset,
 		)
 		if err != nil {
 			return nil, err
@@ -275,7 +310,8 @@ SELECT
 	IS_NULLABLE,
 	COLUMN_KEY,
 	COLUMN_DEFAULT,
-	EXTRA
+	EXTRA,
+	IFNULL(CHARACTER_SET_NAME, '')
 FROM information_schema.COLUMNS
 WHERE information_schema.COLUMNS.TABLE_SCHEMA = ?
 `, schemaName)
@@ -295,6 +331,7 @@ WHERE information_schema.COLUMNS.TABLE_SCHEMA = ?
 			&tableInfo.Key,
 			&tableInfo.Default,
 			&tableInfo.Extra,
+			&tableInfo.Charset,
 		)
 		if err != nil {
 			return nil, err
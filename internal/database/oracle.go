@@ -129,6 +129,34 @@ func (db *OracleDBRepository) SchemaTables(ctx context.Context) (map[string][]st
 	return databaseTables, nil
 }
 
+func (db *OracleDBRepository) SchemaViews(ctx context.Context) (map[string][]string, error) {
+	rows, err := db.Conn.QueryContext(
+		ctx,
+		`
+	SELECT OWNER, VIEW_NAME
+      FROM SYS.ALL_VIEWS
+  ORDER BY OWNER, VIEW_NAME
+		`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	databaseViews := map[string][]string{}
+	for rows.Next() {
+		var schema, view string
+		if err := rows.Scan(&schema, &view); err != nil {
+			return nil, err
+		}
+
+		if arr, ok := databaseViews[schema]; ok {
+			databaseViews[schema] = append(arr, view)
+		} else {
+			databaseViews[schema] = []string{view}
+		}
+	}
+	return databaseViews, nil
+}
+
 func (db *OracleDBRepository) Tables(ctx context.Context) ([]string, error) {
 	rows, err := db.Conn.QueryContext(ctx, "SELECT TABLE_NAME FROM USER_TABLES")
 	if err != nil {
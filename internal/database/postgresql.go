@@ -191,6 +191,39 @@ func (db *PostgreSQLDBRepository) SchemaTables(ctx context.Context) (map[string]
 	return databaseTables, nil
 }
 
+func (db *PostgreSQLDBRepository) SchemaViews(ctx context.Context) (map[string][]string, error) {
+	rows, err := db.Conn.QueryContext(
+		ctx,
+		`
+	SELECT
+		table_schema,
+		table_name
+	FROM
+		information_schema.views
+	ORDER BY
+		table_schema,
+		table_name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	databaseViews := map[string][]string{}
+	for rows.Next() {
+		var schema, view string
+		if err := rows.Scan(&schema, &view); err != nil {
+			return nil, err
+		}
+
+		if arr, ok := databaseViews[schema]; ok {
+			databaseViews[schema] = append(arr, view)
+		} else {
+			databaseViews[schema] = []string{view}
+		}
+	}
+	return databaseViews, nil
+}
+
 func (db *PostgreSQLDBRepository) Tables(ctx context.Context) ([]string, error) {
 	rows, err := db.Conn.QueryContext(
 		ctx,
@@ -235,7 +268,11 @@ func (db *PostgreSQLDBRepository) DescribeDatabaseTable(ctx context.Context) ([]
 			ELSE 'NO'
 		END,
 		c.column_default,
-		''
+		CASE
+			WHEN c.is_identity = 'YES' THEN 'identity'
+			WHEN c.is_generated = 'ALWAYS' THEN 'generated'
+			ELSE ''
+		END
 	FROM
 		information_schema.columns c
 	LEFT JOIN (
@@ -299,7 +336,11 @@ func (db *PostgreSQLDBRepository) DescribeDatabaseTableBySchema(ctx context.Cont
 			ELSE 'NO'
 		END,
 		c.column_default,
-		''
+		CASE
+			WHEN c.is_identity = 'YES' THEN 'identity'
+			WHEN c.is_generated = 'ALWAYS' THEN 'generated'
+			ELSE ''
+		END
 	FROM
 		information_schema.columns c
 	LEFT JOIN (
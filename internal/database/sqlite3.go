@@ -89,6 +89,32 @@ func (db *SQLite3DBRepository) Tables(ctx context.Context) ([]string, error) {
 	return tables, nil
 }
 
+func (db *SQLite3DBRepository) SchemaViews(ctx context.Context) (map[string][]string, error) {
+	rows, err := db.Conn.QueryContext(ctx, `
+	SELECT
+	  name
+	FROM
+	  sqlite_master
+	WHERE
+	  type = 'view'
+	ORDER BY
+	  name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	views := []string{}
+	for rows.Next() {
+		var view string
+		if err := rows.Scan(&view); err != nil {
+			return nil, err
+		}
+		views = append(views, view)
+	}
+	return map[string][]string{"": views}, nil
+}
+
 func (db *SQLite3DBRepository) describeTable(ctx context.Context, tableName string) ([]*ColumnDesc, error) {
 	rows, err := db.Conn.QueryContext(ctx, fmt.Sprintf("PRAGMA table_info(%s);", tableName))
 	if err != nil {
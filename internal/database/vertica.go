@@ -129,6 +129,34 @@ func (db *VerticaDBRepository) SchemaTables(ctx context.Context) (map[string][]s
 	return databaseTables, nil
 }
 
+func (db *VerticaDBRepository) SchemaViews(ctx context.Context) (map[string][]string, error) {
+	rows, err := db.Conn.QueryContext(
+		ctx,
+		`
+    SELECT table_schema, table_name
+      FROM v_catalog.views
+     ORDER BY table_schema, table_name
+    `)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	databaseViews := map[string][]string{}
+	for rows.Next() {
+		var schema, view string
+		if err := rows.Scan(&schema, &view); err != nil {
+			return nil, err
+		}
+
+		if arr, ok := databaseViews[schema]; ok {
+			databaseViews[schema] = append(arr, view)
+		} else {
+			databaseViews[schema] = []string{view}
+		}
+	}
+	return databaseViews, nil
+}
+
 func (db *VerticaDBRepository) Tables(ctx context.Context) ([]string, error) {
 	rows, err := db.Conn.QueryContext(ctx, "SELECT table_name FROM v_catalog.tables ORDER BY 1")
 	if err != nil {
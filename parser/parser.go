@@ -263,6 +263,7 @@ var multiKeywordMap = map[string][]string{
 	"LEFT":    {"OUTER", "JOIN"},
 	"RIGHT":   {"OUTER", "JOIN"},
 	"NATURAL": {"LEFT", "RIGHT", "OUTER", "JOIN"},
+	"CONNECT": {"BY"},
 }
 
 func genMultiKeywordPrefixMatcher() astutil.NodeMatcher {
@@ -564,6 +564,49 @@ func TestParseMultiKeyword(t *testing.T) {
 				testMultiKeyword(t, list[0], input)
 			},
 		},
+		{
+			name:  "connect by keyword",
+			input: "connect by",
+			checkFn: func(t *testing.T, stmts []*ast.Statement, input string) {
+				testStatement(t, stmts[0], 1, input)
+				list := stmts[0].GetTokens()
+				testMultiKeyword(t, list[0], input)
+			},
+		},
+		{
+			name:  "select with start with and connect by keyword",
+			input: "select id from abc start with parent_id = 0 connect by prior id = parent_id",
+			checkFn: func(t *testing.T, stmts []*ast.Statement, input string) {
+				testStatement(t, stmts[0], 19, input)
+				list := stmts[0].GetTokens()
+				testItem(t, list[0], "select")
+				testIdentifier(t, list[2], "id")
+				testItem(t, list[4], "from")
+				testIdentifier(t, list[6], "abc")
+				testItem(t, list[8], "start")
+				testItem(t, list[10], "with")
+				testComparison(t, list[12], "parent_id = 0", "parent_id", "=", "0")
+				testMultiKeyword(t, list[14], "connect by")
+				testIdentifier(t, list[16], "prior")
+				testComparison(t, list[18], "id = parent_id", "id", "=", "parent_id")
+			},
+		},
+		{
+			name:  "select with connect by nocycle keyword",
+			input: "select id from abc connect by nocycle prior id = parent_id start with parent_id = 0",
+			checkFn: func(t *testing.T, stmts []*ast.Statement, input string) {
+				testStatement(t, stmts[0], 21, input)
+				list := stmts[0].GetTokens()
+				testIdentifier(t, list[6], "abc")
+				testMultiKeyword(t, list[8], "connect by")
+				testItem(t, list[10], "nocycle")
+				testIdentifier(t, list[12], "prior")
+				testComparison(t, list[14], "id = parent_id", "id", "=", "parent_id")
+				testItem(t, list[16], "start")
+				testItem(t, list[18], "with")
+				testComparison(t, list[20], "parent_id = 0", "parent_id", "=", "0")
+			},
+		},
 		{
 			name:  "select with group keyword",
 			input: "select a, b, c from abc group by d, e, f",
@@ -0,0 +1,322 @@
+package parseutil
+
+import (
+	"strings"
+
+	"github.com/sqls-server/sqls/ast"
+	"github.com/sqls-server/sqls/token"
+)
+
+type ColumnClause int
+
+const (
+	_ ColumnClause = iota
+	ColumnClauseSelect
+	ColumnClauseWhere
+	ColumnClauseOn
+	ColumnClauseGroupBy
+	ColumnClauseHaving
+	ColumnClauseOrderBy
+	ColumnClauseOver
+)
+
+func (cc ColumnClause) String() string {
+	switch cc {
+	case ColumnClauseSelect:
+		return "SELECT"
+	case ColumnClauseWhere:
+		return "WHERE"
+	case ColumnClauseOn:
+		return "ON"
+	case ColumnClauseGroupBy:
+		return "GROUP BY"
+	case ColumnClauseHaving:
+		return "HAVING"
+	case ColumnClauseOrderBy:
+		return "ORDER BY"
+	case ColumnClauseOver:
+		return "OVER"
+	}
+	return ""
+}
+
+type ColumnRef struct {
+	Column *ast.Identifier
+	// Qualifier is the table name or alias in front of the column, if any.
+	Qualifier *ast.Identifier
+	// Parents holds the database and schema parts of a multi-part name,
+	// outermost first.
+	Parents []*ast.Identifier
+	Clause  ColumnClause
+}
+
+// ExtractColumnReferences returns the column references found in the
+// SELECT, WHERE, ON, GROUP BY, HAVING and ORDER BY clauses and in window
+// OVER clauses of parsed, including those of sub queries. Table references,
+// alias names, select list aliases used in ORDER BY, function names and
+// wildcards are not reported.
+func ExtractColumnReferences(parsed ast.TokenList) []ColumnRef {
+	c := &columnRefCollector{refs: []ColumnRef{}}
+	c.walk(parsed, 0, false, map[string]bool{})
+	c.flush()
+	return c.refs
+}
+
+// columnRefCollector assembles column names from identifier and "." leaves
+// in document order. The parser only pairs two parts into a
+// MemberIdentifier, so a name such as schema.table.column may be split
+// across neighbouring nodes, e.g. "s.t" "." and a comparison starting with
+// "column".
+type columnRefCollector struct {
+	refs []ColumnRef
+
+	parts   []*ast.Identifier
+	dotted  bool
+	clause  ColumnClause
+	aliases map[string]bool
+}
+
+// walk collects the column references of list. aliases holds the select
+// list aliases of the enclosing query. When fixed is set, clause keywords
+// inside list do not change the clause, as in an OVER clause where
+// PARTITION BY and ORDER BY belong to the window.
+func (c *columnRefCollector) walk(list ast.TokenList, clause ColumnClause, fixed bool, aliases map[string]bool) {
+	nodes := list.GetTokens()
+	for i := 0; i < len(nodes); i++ {
+		node := nodes[i]
+		if cc, ok := columnClauseKeyword(node); ok {
+			c.flush()
+			if !fixed {
+				clause = cc
+			}
+			continue
+		}
+		// START only ends the clause as part of START WITH, so that a column
+		// named start is still reported.
+		if isKeywordNode(node, "START") {
+			if next := nextNonWhitespace(nodes, i+1); next < len(nodes) && isKeywordNode(nodes[next], "WITH") {
+				c.flush()
+				if !fixed {
+					clause = 0
+				}
+				i = next
+				continue
+			}
+		}
+		if isKeywordNode(node, "OVER") {
+			c.flush()
+			next := nextNonWhitespace(nodes, i+1)
+			if next == len(nodes) {
+				continue
+			}
+			// A named window such as OVER w is not a column reference.
+			if window, ok := nodes[next].(*ast.Parenthesis); ok {
+				c.walk(window, ColumnClauseOver, true, aliases)
+				c.flush()
+			}
+			i = next
+			continue
+		}
+		if item, ok := node.(*ast.Item); ok {
+			tok := item.GetToken()
+			// A bind variable such as :name is not a column reference.
+			if tok.MatchKind(token.Colon) {
+				c.flush()
+				if i+1 < len(nodes) {
+					if _, ok := nodes[i+1].(*ast.Identifier); ok {
+						i++
+					}
+				}
+				continue
+			}
+			// The parser leaves column names that are keywords, such as date
+			// or t.value, as keyword items.
+			if tok.MatchKind(token.SQLKeyword) && (c.dotted || isKeywordOperand(nodes, i)) {
+				c.addPart(&ast.Identifier{Tok: tok}, clause, aliases)
+				continue
+			}
+		}
+		c.node(node, clause, fixed, aliases)
+	}
+}
+
+func (c *columnRefCollector) node(node ast.Node, clause ColumnClause, fixed bool, aliases map[string]bool) {
+	switch v := node.(type) {
+	case *ast.Identifier:
+		c.addPart(v, clause, aliases)
+	case *ast.Item:
+		if v.String() == "." && len(c.parts) > 0 && !c.dotted {
+			c.dotted = true
+			return
+		}
+		c.flush()
+	case *ast.Aliased:
+		c.flush()
+		if v.RealName != nil {
+			c.node(v.RealName, clause, fixed, aliases)
+			c.flush()
+		}
+		if clause == ColumnClauseSelect {
+			if alias := v.GetAliasedNameIdent(); alias.Tok != nil {
+				aliases[strings.ToUpper(alias.NoQuoteString())] = true
+			}
+		}
+	case *ast.Statement:
+		c.flush()
+		c.walk(v, 0, false, map[string]bool{})
+		c.flush()
+	case *ast.Parenthesis:
+		c.flush()
+		if isSubQuery(v) {
+			c.walk(v, clause, false, map[string]bool{})
+		} else {
+			c.walk(v, clause, fixed, aliases)
+		}
+		c.flush()
+	case ast.TokenList:
+		c.walk(v, clause, fixed, aliases)
+	default:
+		c.flush()
+	}
+}
+
+func (c *columnRefCollector) addPart(ident *ast.Identifier, clause ColumnClause, aliases map[string]bool) {
+	if c.dotted {
+		c.parts = append(c.parts, ident)
+		c.dotted = false
+		return
+	}
+	c.flush()
+	// @name is a bind variable or a session variable.
+	if strings.HasPrefix(ident.String(), "@") {
+		return
+	}
+	c.parts = []*ast.Identifier{ident}
+	c.clause = clause
+	c.aliases = aliases
+}
+
+func (c *columnRefCollector) flush() {
+	parts, dotted := c.parts, c.dotted
+	c.parts = nil
+	c.dotted = false
+	// A name ending in "." such as t. is still being typed.
+	if c.clause == 0 || len(parts) == 0 || dotted {
+		return
+	}
+	column := parts[len(parts)-1]
+	if column.IsWildcard() {
+		return
+	}
+	if len(parts) == 1 {
+		if c.clause == ColumnClauseOrderBy && c.aliases[strings.ToUpper(column.NoQuoteString())] {
+			return
+		}
+		c.refs = append(c.refs, ColumnRef{Column: column, Clause: c.clause})
+		return
+	}
+	c.refs = append(c.refs, ColumnRef{
+		Column:    column,
+		Qualifier: parts[len(parts)-2],
+		Parents:   parts[:len(parts)-2],
+		Clause:    c.clause,
+	})
+}
+
+func isKeywordNode(node ast.Node, keyword string) bool {
+	item, ok := node.(*ast.Item)
+	return ok && strings.EqualFold(item.String(), keyword)
+}
+
+// isKeywordOperand reports whether the keyword item at nodes[i] stands on
+// its own between list separators, operators or clause keywords, as a
+// column named date does in "SELECT date, value FROM t".
+func isKeywordOperand(nodes []ast.Node, i int) bool {
+	word := strings.ToUpper(nodes[i].String())
+	switch word {
+	case "NULL", "TRUE", "FALSE", "DEFAULT", "ALL", "DISTINCT", "NOT", "AND", "OR",
+		"CASE", "WHEN", "THEN", "ELSE", "END", "ASC", "DESC", "IS", "IN", "LIKE", "BETWEEN", "EXISTS", "AS":
+		return false
+	}
+	if strings.HasPrefix(word, "CURRENT_") {
+		return false
+	}
+	return isOperandBoundary(nodes, prevNonWhitespace(nodes, i-1)) &&
+		isOperandBoundary(nodes, nextNonWhitespace(nodes, i+1))
+}
+
+func isOperandBoundary(nodes []ast.Node, i int) bool {
+	if i < 0 || i >= len(nodes) {
+		return true
+	}
+	if _, ok := columnClauseKeyword(nodes[i]); ok {
+		return true
+	}
+	item, ok := nodes[i].(*ast.Item)
+	if !ok {
+		return false
+	}
+	tok := item.GetToken()
+	switch tok.Kind {
+	case token.Comma, token.Eq, token.Neq, token.Lt, token.Gt, token.LtEq, token.GtEq,
+		token.Plus, token.Minus, token.Mult, token.Div, token.Caret, token.Mod,
+		token.LParen, token.RParen, token.DoubleColon, token.Semicolon:
+		return true
+	}
+	return tok.MatchSQLKeywords([]string{
+		"AND", "OR", "NOT", "WHEN", "THEN", "ELSE", "END", "DISTINCT",
+		"ASC", "DESC", "IS", "IN", "LIKE", "BETWEEN",
+	})
+}
+
+func prevNonWhitespace(nodes []ast.Node, start int) int {
+	for i := start; i >= 0; i-- {
+		item, ok := nodes[i].(*ast.Item)
+		if !ok || !item.GetToken().MatchKind(token.Whitespace) {
+			return i
+		}
+	}
+	return -1
+}
+
+func nextNonWhitespace(nodes []ast.Node, start int) int {
+	for i := start; i < len(nodes); i++ {
+		item, ok := nodes[i].(*ast.Item)
+		if !ok || !item.GetToken().MatchKind(token.Whitespace) {
+			return i
+		}
+	}
+	return len(nodes)
+}
+
+// columnClauseKeyword reports whether node is a keyword that switches the
+// clause. Keywords introducing table references return the zero clause so
+// that table names are not taken for columns.
+func columnClauseKeyword(node ast.Node) (ColumnClause, bool) {
+	switch node.(type) {
+	case *ast.Item, *ast.MultiKeyword:
+	default:
+		return 0, false
+	}
+	keyword := strings.ToUpper(strings.Join(strings.Fields(node.String()), " "))
+	switch keyword {
+	case "SELECT":
+		return ColumnClauseSelect, true
+	case "WHERE":
+		return ColumnClauseWhere, true
+	case "ON":
+		return ColumnClauseOn, true
+	case "GROUP BY":
+		return ColumnClauseGroupBy, true
+	case "HAVING":
+		return ColumnClauseHaving, true
+	case "ORDER BY":
+		return ColumnClauseOrderBy, true
+	case "FROM", "UPDATE", "INTO", "USING", "SET", "VALUES", "LIMIT", "OFFSET", "UNION", "UNION ALL", "EXCEPT", "INTERSECT", "CONNECT BY":
+		return 0, true
+	}
+	if strings.HasSuffix(keyword, "JOIN") {
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,162 @@
+package parseutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExtractColumnReferences(t *testing.T) {
+	testcases := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "select",
+			input: "SELECT a, t.b, c AS cc, count(d) FROM t",
+			want:  []string{"SELECT a", "SELECT t.b", "SELECT c", "SELECT d"},
+		},
+		{
+			name:  "select wildcard",
+			input: "SELECT *, t.* FROM t",
+			want:  []string{},
+		},
+		{
+			name:  "where",
+			input: "SELECT a FROM t WHERE b = 1 AND t.c > 2",
+			want:  []string{"SELECT a", "WHERE b", "WHERE t.c"},
+		},
+		{
+			name:  "on",
+			input: "SELECT a FROM t1 x LEFT JOIN t2 y ON x.id = y.id",
+			want:  []string{"SELECT a", "ON x.id", "ON y.id"},
+		},
+		{
+			name:  "group by",
+			input: "SELECT a FROM t GROUP BY a, t.b",
+			want:  []string{"SELECT a", "GROUP BY a", "GROUP BY t.b"},
+		},
+		{
+			name:  "having",
+			input: "SELECT a FROM t GROUP BY a HAVING count(b) > 1",
+			want:  []string{"SELECT a", "GROUP BY a", "HAVING b"},
+		},
+		{
+			name:  "order by",
+			input: "SELECT a FROM t ORDER BY b DESC, t.c",
+			want:  []string{"SELECT a", "ORDER BY b", "ORDER BY t.c"},
+		},
+		{
+			name:  "order by select alias",
+			input: "SELECT t.a AS x, b y FROM t ORDER BY x, t.x, y, c",
+			want:  []string{"SELECT t.a", "SELECT b", "ORDER BY t.x", "ORDER BY c"},
+		},
+		{
+			name:  "three part name",
+			input: "SELECT s.t.c FROM s.t WHERE s.t.d = 1",
+			want:  []string{"SELECT s.t.c", "WHERE s.t.d"},
+		},
+		{
+			name:  "three part names in comparison",
+			input: "SELECT a FROM t WHERE x.y.z > s.t.e",
+			want:  []string{"SELECT a", "WHERE x.y.z", "WHERE s.t.e"},
+		},
+		{
+			name:  "four part name",
+			input: "SELECT db.s.t.c FROM t",
+			want:  []string{"SELECT db.s.t.c"},
+		},
+		{
+			name:  "window",
+			input: "SELECT row_number() OVER (PARTITION BY a ORDER BY b), c FROM t ORDER BY d",
+			want:  []string{"OVER a", "OVER b", "SELECT c", "ORDER BY d"},
+		},
+		{
+			name:  "named window",
+			input: "SELECT sum(a) OVER w FROM t",
+			want:  []string{"SELECT a"},
+		},
+		{
+			name:  "connect by",
+			input: "SELECT a FROM t WHERE b = 1 START WITH c = 0 CONNECT BY PRIOR id = parent_id",
+			want:  []string{"SELECT a", "WHERE b"},
+		},
+		{
+			name:  "start column",
+			input: "SELECT start, b FROM t WHERE start > 1 AND b = 2 ORDER BY start, b",
+			want:  []string{"SELECT start", "SELECT b", "WHERE start", "WHERE b", "ORDER BY start", "ORDER BY b"},
+		},
+		{
+			name:  "keyword columns",
+			input: "SELECT u.name, o.date, t.value, t.user, t.key FROM t",
+			want:  []string{"SELECT u.name", "SELECT o.date", "SELECT t.value", "SELECT t.user", "SELECT t.key"},
+		},
+		{
+			name:  "unqualified keyword columns",
+			input: "SELECT DISTINCT date, value, key FROM t WHERE value = 1 ORDER BY date DESC",
+			want:  []string{"SELECT date", "SELECT value", "SELECT key", "WHERE value", "ORDER BY date"},
+		},
+		{
+			name:  "keywords are not columns",
+			input: "SELECT NULL, CURRENT_DATE FROM t WHERE a IS NOT NULL",
+			want:  []string{"WHERE a"},
+		},
+		{
+			name:  "trailing qualifier",
+			input: "SELECT a FROM t WHERE t.",
+			want:  []string{"SELECT a"},
+		},
+		{
+			name:  "bind variables",
+			input: "SELECT a FROM t WHERE x = :p AND y = @v AND z > :q",
+			want:  []string{"SELECT a", "WHERE x", "WHERE y", "WHERE z"},
+		},
+		{
+			name:  "case",
+			input: "SELECT CASE WHEN a > 1 THEN t.b ELSE c END FROM t",
+			want:  []string{"SELECT a", "SELECT t.b", "SELECT c"},
+		},
+		{
+			name:  "in sub query",
+			input: "SELECT a FROM t WHERE b IN (SELECT c FROM u WHERE d = 1)",
+			want:  []string{"SELECT a", "WHERE b", "SELECT c", "WHERE d"},
+		},
+		{
+			name:  "exists sub query",
+			input: "SELECT a FROM t WHERE EXISTS (SELECT 1 FROM u WHERE u.id = t.id)",
+			want:  []string{"SELECT a", "WHERE u.id", "WHERE t.id"},
+		},
+		{
+			name:  "sub query",
+			input: "SELECT s.a FROM (SELECT a FROM t WHERE b = 1) AS s WHERE s.c = 2",
+			want:  []string{"SELECT s.a", "SELECT a", "WHERE b", "WHERE s.c"},
+		},
+		{
+			name:  "sub query alias scope",
+			input: "SELECT a AS x FROM (SELECT b FROM t ORDER BY x) AS s ORDER BY x",
+			want:  []string{"SELECT a", "SELECT b", "ORDER BY x"},
+		},
+	}
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			query := initExtractTable(t, tt.input)
+			got := []string{}
+			for _, ref := range ExtractColumnReferences(query) {
+				names := []string{}
+				for _, parent := range ref.Parents {
+					names = append(names, parent.String())
+				}
+				if ref.Qualifier != nil {
+					names = append(names, ref.Qualifier.String())
+				}
+				names = append(names, ref.Column.String())
+				got = append(got, ref.Clause.String()+" "+strings.Join(names, "."))
+			}
+			if d := cmp.Diff(tt.want, got); d != "" {
+				t.Errorf("unmatched value: %s", d)
+			}
+		})
+	}
+}
@@ -119,6 +119,61 @@ func ExtractWhereCondition(parsed ast.TokenList) []ast.Node {
 	return filterPrefixGroup(astutil.NewNodeReader(parsed), prefixMatcher, peekMatcher)
 }
 
+// ExtractConnectByClause returns the nodes of the hierarchical condition
+// following CONNECT BY, up to the next clause. PRIOR may appear on either
+// side of a comparison and is kept as part of the condition.
+func ExtractConnectByClause(parsed ast.TokenList) []ast.Node {
+	prefixMatcher := astutil.NodeMatcher{
+		ExpectKeyword: []string{
+			"CONNECT BY",
+		},
+	}
+	return parsePrefix(astutil.NewNodeReader(parsed), prefixMatcher, parseConnectByCondition)
+}
+
+func parseConnectByCondition(reader *astutil.NodeReader) []ast.Node {
+	modifierMatcher := astutil.NodeMatcher{
+		ExpectKeyword: []string{
+			"NOCYCLE",
+		},
+	}
+	endMatcher := astutil.NodeMatcher{
+		ExpectTokens: []token.Kind{
+			token.Semicolon,
+			token.RParen,
+		},
+		ExpectKeyword: []string{
+			"START",
+			"ORDER",
+			"ORDER BY",
+			"GROUP BY",
+			"HAVING",
+			"UNION",
+			"MINUS",
+			"INTERSECT",
+			"EXCEPT",
+			"OFFSET",
+			"FETCH",
+			"FOR",
+		},
+	}
+	if reader.PeekNodeIs(true, modifierMatcher) {
+		reader.NextNode(true)
+	}
+	startIndex, node := reader.PeekNode(true)
+	if node == nil || endMatcher.IsMatch(node) {
+		return []ast.Node{}
+	}
+	for {
+		_, node := reader.PeekNode(true)
+		if node == nil || endMatcher.IsMatch(node) {
+			break
+		}
+		reader.NextNode(true)
+	}
+	return reader.NodesWithRange(startIndex, reader.Index)
+}
+
 func ExtractAliased(parsed ast.TokenList) []ast.Node {
 	reader := astutil.NewNodeReader(parsed)
 	matcher := astutil.NodeMatcher{NodeTypes: []ast.NodeType{ast.TypeAliased}}
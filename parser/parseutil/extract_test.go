@@ -186,6 +186,96 @@ func TestExtractWhereCondition(t *testing.T) {
 	}
 }
 
+func TestExtractConnectByClause(t *testing.T) {
+	testcases := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "only",
+			input: "CONNECT BY parent_id = id",
+			want:  "parent_id = id",
+		},
+		{
+			name:  "leading PRIOR",
+			input: "SELECT id FROM abc START WITH parent_id IS NULL CONNECT BY PRIOR id = parent_id",
+			want:  "PRIOR id = parent_id",
+		},
+		{
+			name:  "trailing PRIOR",
+			input: "SELECT id FROM abc CONNECT BY parent_id = PRIOR id",
+			want:  "parent_id = PRIOR id",
+		},
+		{
+			name:  "AND",
+			input: "SELECT id FROM abc CONNECT BY PRIOR id = parent_id AND lvl < 3",
+			want:  "PRIOR id = parent_id AND lvl < 3",
+		},
+		{
+			name:  "NOCYCLE",
+			input: "SELECT id FROM abc CONNECT BY NOCYCLE PRIOR id = parent_id",
+			want:  "PRIOR id = parent_id",
+		},
+		{
+			name:  "START WITH",
+			input: "SELECT id FROM abc CONNECT BY PRIOR id = parent_id START WITH parent_id IS NULL",
+			want:  "PRIOR id = parent_id",
+		},
+		{
+			name:  "ORDER BY",
+			input: "SELECT id FROM abc CONNECT BY PRIOR id = parent_id ORDER BY id",
+			want:  "PRIOR id = parent_id",
+		},
+		{
+			name:  "ORDER SIBLINGS BY",
+			input: "SELECT id FROM abc CONNECT BY PRIOR id = parent_id ORDER SIBLINGS BY id",
+			want:  "PRIOR id = parent_id",
+		},
+		{
+			name:  "FETCH FIRST",
+			input: "SELECT id FROM abc CONNECT BY PRIOR id = parent_id FETCH FIRST 10 ROWS ONLY",
+			want:  "PRIOR id = parent_id",
+		},
+		{
+			name:  "OFFSET",
+			input: "SELECT id FROM abc CONNECT BY PRIOR id = parent_id OFFSET 5 ROWS",
+			want:  "PRIOR id = parent_id",
+		},
+		{
+			name:  "FOR UPDATE",
+			input: "SELECT id FROM abc CONNECT BY PRIOR id = parent_id FOR UPDATE",
+			want:  "PRIOR id = parent_id",
+		},
+		{
+			name:  "semicolon",
+			input: "SELECT id FROM abc CONNECT BY PRIOR id = parent_id;",
+			want:  "PRIOR id = parent_id",
+		},
+		{
+			name:  "sub query",
+			input: "SELECT * FROM (SELECT id FROM abc CONNECT BY PRIOR id = parent_id) t",
+			want:  "PRIOR id = parent_id",
+		},
+	}
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			query := initExtractTable(t, tt.input)
+			got := ExtractConnectByClause(query)
+			if len(got) == 0 {
+				t.Fatalf("not found filtered node")
+			}
+			var condition string
+			for _, node := range got {
+				condition += node.String()
+			}
+			if tt.want != condition {
+				t.Errorf("expected %q, got %q", tt.want, condition)
+			}
+		})
+	}
+}
+
 func TestExtractAliasedIdentifier(t *testing.T) {
 	testcases := []struct {
 		name  string